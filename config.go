@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Config describes the set of instances to run and how each one's data
+// source is constructed. It is loaded from the file passed via --config;
+// when no --config is given, main() falls back to synthesizing a Config
+// full of DummySource instances sized by --instances.
+type Config struct {
+	Instances []InstanceConfig `toml:"instance" yaml:"instances"`
+}
+
+// InstanceConfig configures one dashboard row. Source selects which Source
+// implementation to build: "dummy" (default), "process", "pty", or "file".
+// Command is required for "process" and "pty" (Args may be empty); Path is
+// required for "file". Rules are regexes applied to each scraped line to derive
+// TPS/pending updates (ignored for "file", which has no rules to apply).
+type InstanceConfig struct {
+	ID      int          `toml:"id" yaml:"id"`
+	Source  string       `toml:"source" yaml:"source"`
+	Command string       `toml:"command" yaml:"command"`
+	Args    []string     `toml:"args" yaml:"args"`
+	Path    string       `toml:"path" yaml:"path"`
+	Rules   []RuleConfig `toml:"rule" yaml:"rules"`
+}
+
+// RuleConfig is the on-disk form of a ParseRule: Pattern is compiled once
+// at load time and must contain exactly one capture group.
+type RuleConfig struct {
+	Pattern string `toml:"pattern" yaml:"pattern"`
+	Field   string `toml:"field" yaml:"field"`
+}
+
+// loadConfig reads and parses the instance config at path. The format is
+// chosen by extension: .toml for TOML, .yaml/.yml for YAML.
+func loadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("read config: %w", err)
+	}
+
+	var cfg Config
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".toml":
+		if _, err := toml.Decode(string(data), &cfg); err != nil {
+			return Config{}, fmt.Errorf("parse toml config: %w", err)
+		}
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return Config{}, fmt.Errorf("parse yaml config: %w", err)
+		}
+	default:
+		return Config{}, fmt.Errorf("unrecognized config extension %q (want .toml, .yaml, or .yml)", ext)
+	}
+
+	return cfg, nil
+}
+
+// buildSource constructs the Source described by an InstanceConfig.
+func buildSource(ic InstanceConfig) (Source, error) {
+	rules := make([]ParseRule, 0, len(ic.Rules))
+	for _, rc := range ic.Rules {
+		re, err := regexp.Compile(rc.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("instance %d: compile rule pattern %q: %w", ic.ID, rc.Pattern, err)
+		}
+		rules = append(rules, ParseRule{Pattern: re, Field: rc.Field})
+	}
+
+	switch ic.Source {
+	case "", "dummy":
+		return DummySource{ID: ic.ID}, nil
+	case "process":
+		return ProcessSource{ID: ic.ID, Command: ic.Command, Args: ic.Args, Rules: rules}, nil
+	case "pty":
+		return PTYSource{ID: ic.ID, Command: ic.Command, Args: ic.Args, Rules: rules}, nil
+	case "file":
+		if ic.Path == "" {
+			return nil, fmt.Errorf("instance %d: source \"file\" requires path", ic.ID)
+		}
+		return FileSource{ID: ic.ID, Path: ic.Path}, nil
+	default:
+		return nil, fmt.Errorf("instance %d: unknown source type %q", ic.ID, ic.Source)
+	}
+}
@@ -3,36 +3,83 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"math/rand"
-	"strconv"
+	"os"
+	"runtime/debug"
+	"sort"
 	"strings"
 	"time"
 
-	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+// Layout constants for the log viewport: how much vertical space the header
+// and footer chrome around the viewport consume, so the viewport itself can
+// be sized to exactly fill what's left on tea.WindowSizeMsg.
+const (
+	headerHeight = 4
+	footerHeight = 2
 )
 
+// Split-layout constants: how much of the terminal width the instance
+// table takes versus the log pane, and how far ,/. can shift that ratio.
+const (
+	defaultSplitRatio = 0.4
+	minSplitRatio     = 0.15
+	maxSplitRatio     = 0.75
+	splitStep         = 0.05
+	dividerWidth      = 1
+)
+
+// sparklineSamples is how many historical TPS samples each instance (and
+// the fleet aggregate) keeps for its sparkline, one recorded per tickMsg.
+const sparklineSamples = 30
+
 type instance struct {
-	id      int
-	tps     int
-	pending int
-	logChan chan string
-	logBuf  []string
+	id         int
+	tps        int
+	pending    int
+	tpsHistory []int
+	logChan    chan string
+	logBuf     []string
+}
+
+// pushTPS appends the instance's current TPS to its rolling history,
+// keeping at most sparklineSamples entries.
+func (inst *instance) pushTPS() {
+	inst.tpsHistory = append(inst.tpsHistory, inst.tps)
+	if len(inst.tpsHistory) > sparklineSamples {
+		inst.tpsHistory = inst.tpsHistory[len(inst.tpsHistory)-sparklineSamples:]
+	}
 }
 
 type model struct {
-	inst     []instance
-	view     string
-	activeID int
-	input    textinput.Model
-	tick     time.Time
-	width    int
-	height   int
-	errMsg   string
-	errTimer int
+	inst   []instance
+	cursor int    // selected row in the instance table
+	focus  string // "table" or "log" — which pane receives key input
+	tick   time.Time
+	width  int
+	height int
+
+	splitRatio float64 // fraction of width given to the table pane
+
+	logVP       viewport.Model
+	vpReady     bool
+	filtering   bool
+	filterInput textinput.Model
+	filterQuery string
+
+	maxPending   int
+	progressBar  progress.Model
+	fleetHistory []int
 }
 
 type tickMsg time.Time
@@ -40,94 +87,139 @@ type logMsg struct {
 	id   int
 	line string
 }
+type diagMsg string
 
 var (
 	headerStyle = lipgloss.NewStyle().Bold(true).Underline(true)
 	boldStyle   = lipgloss.NewStyle().Bold(true)
-	errorStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
-	logBus      = make(chan logMsg, 256) // send-only for producers
+	matchStyle  = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("214"))
+	cursorStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("214"))
+
+	focusedPaneStyle   = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("214"))
+	unfocusedPaneStyle = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("240"))
+
+	logBus = make(chan logMsg, 256) // send-only for producers
+
+	// diag carries unmanaged diagnostic output — startup banners, config
+	// warnings, recovered panics from instance goroutines — that should be
+	// printed above the alt-screen dashboard via tea.Printf rather than
+	// corrupting the TUI or being swallowed.
+	diag = make(chan string, 64)
 )
 
 func main() {
-	var instFlag = flag.Int("instances", 0, "number of dummy instances")
+	var (
+		instFlag       = flag.Int("instances", 0, "number of dummy instances (ignored if --config is set)")
+		configFlag     = flag.String("config", "", "path to a TOML/YAML config describing instance sources")
+		maxPendingFlag = flag.Int("max-pending", 20, "pending queue depth that fills the progress bar")
+	)
 	flag.Parse()
 
-	n := *instFlag
-	if n <= 0 {
-		n = rand.Intn(20) + 10
+	var cfg Config
+	if *configFlag != "" {
+		var err error
+		cfg, err = loadConfig(*configFlag)
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			os.Exit(1)
+		}
+	} else {
+		n := *instFlag
+		if n <= 0 {
+			n = rand.Intn(20) + 10
+		}
+		cfg.Instances = make([]InstanceConfig, n)
+		for i := 0; i < n; i++ {
+			cfg.Instances[i] = InstanceConfig{ID: i, Source: "dummy"}
+		}
 	}
 
-	instances := make([]instance, n)
-	for i := 0; i < n; i++ {
+	instances := make([]instance, len(cfg.Instances))
+	sources := make([]Source, len(cfg.Instances))
+	for i, ic := range cfg.Instances {
+		// The model indexes m.inst by slot, so an instance's id must equal
+		// its slice index regardless of what the config says.
+		ic.ID = i
 		instances[i] = instance{
 			id:      i,
-			tps:     rand.Intn(50) + 10,
-			pending: rand.Intn(20),
 			logChan: make(chan string, 100),
 			logBuf:  make([]string, 0, 100),
 		}
+		src, err := buildSource(ic)
+		if err != nil {
+			fmt.Printf("Error configuring instance %d: %v\n", i, err)
+			os.Exit(1)
+		}
+		sources[i] = src
 	}
 
-	ti := textinput.New()
-	ti.Placeholder = "type number and Enter"
-	ti.Focus()
-	ti.CharLimit = 4
-	ti.Width = 10
+	fi := textinput.New()
+	fi.Placeholder = "fuzzy filter…"
+	fi.CharLimit = 64
+	fi.Width = 30
 
 	m := model{
-		inst:  instances,
-		view:  "dash",
-		input: ti,
-		tick:  time.Now(),
+		inst:        instances,
+		focus:       "table",
+		splitRatio:  defaultSplitRatio,
+		filterInput: fi,
+		tick:        time.Now(),
+		maxPending:  *maxPendingFlag,
+		progressBar: progress.New(progress.WithGradient("#5A56E0", "#EE6FF8"), progress.WithWidth(20)),
 	}
 
-	// Spawn goroutines to simulate instance activity
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Start each instance's source and fan its metrics/log channels into
+	// the shared instance state and logBus.
 	for i := range instances {
-		go func(id int) {
-			randSleep := func() { time.Sleep(time.Duration(400+rand.Intn(400)) * time.Millisecond) }
-			sample := []string{
-				"Getting latest blockhash...",
-				"Got blockhash: %s",
-				"→ Transaction: %s… to %s…",
-				"Batch sent: %d/%d successful",
-			}
+		metricsCh, linesCh, err := sources[i].Start(ctx)
+		if err != nil {
+			fmt.Printf("Error starting instance %d: %v\n", instances[i].id, err)
+			os.Exit(1)
+		}
 
-			for {
-				randSleep()
-
-				instances[id].tps = rand.Intn(50) + 10
-				instances[id].pending = rand.Intn(20)
-				// Generate different log message types
-				switch n := rand.Intn(4); n {
-				case 0:
-					logBus <- logMsg{id, fmt.Sprintf("[Instance %d] %s", id, sample[n])}
-				case 1:
-					bh := randSeq(6)
-					logBus <- logMsg{id, fmt.Sprintf("[Instance %d] %s", id, fmt.Sprintf(sample[n], bh))}
-				case 2:
-					sig := randSeq(7)
-					dest := randSeq(5)
-					logBus <- logMsg{id, fmt.Sprintf("[Instance %d] %s", id, fmt.Sprintf(sample[n], sig, dest))}
-				case 3:
-					good := 30
-					total := 30
-					logBus <- logMsg{id, fmt.Sprintf("[Instance %d] %s", id, fmt.Sprintf(sample[n], good, total))}
+		go func(id int, metricsCh <-chan Metrics, linesCh <-chan string) {
+			defer func() {
+				if r := recover(); r != nil {
+					diag <- fmt.Sprintf("instance %d panicked: %v\n%s", id, r, debug.Stack())
+				}
+			}()
+
+			for metricsCh != nil || linesCh != nil {
+				select {
+				case met, ok := <-metricsCh:
+					if !ok {
+						metricsCh = nil
+						continue
+					}
+					instances[id].tps = met.TPS
+					instances[id].pending = met.Pending
+				case line, ok := <-linesCh:
+					if !ok {
+						linesCh = nil
+						continue
+					}
+					logBus <- logMsg{id, line}
 				}
 			}
-		}(i)
+		}(i, metricsCh, linesCh)
 	}
 
+	diag <- fmt.Sprintf("instance-tui-dashboard: %d instance(s) starting", len(instances))
+
 	p := tea.NewProgram(m, tea.WithAltScreen())
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Error: %v\n", err)
 	}
 
+	cancel()
 	for i := range instances {
 		close(instances[i].logChan)
 	}
 }
 
-
 // randHash generates a random hash-like string of specified length.
 func randHash(length int) string {
 	const charset = "ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz123456789"
@@ -153,7 +245,7 @@ func (m model) Init() tea.Cmd {
 	return tea.Batch(
 		tickCmd(),
 		listenLogs(),
-		m.input.Focus(),
+		listenDiag(),
 	)
 }
 
@@ -171,6 +263,12 @@ func listenLogs() tea.Cmd {
 	}
 }
 
+// listenDiag waits for diagnostic output and converts it to tea.Msg.
+func listenDiag() tea.Cmd {
+	return func() tea.Msg {
+		return diagMsg(<-diag)
+	}
+}
 
 // Update handles incoming messages and updates the model state.
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -180,72 +278,176 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		m.resizeLogVP()
 
 	case tickMsg:
 		m.tick = time.Time(msg)
-		if m.errTimer > 0 {
-			m.errTimer--
-			if m.errTimer == 0 {
-				m.errMsg = ""
-			}
+
+		fleetTPS := 0
+		for i := range m.inst {
+			m.inst[i].pushTPS()
+			fleetTPS += m.inst[i].tps
+		}
+		m.fleetHistory = append(m.fleetHistory, fleetTPS)
+		if len(m.fleetHistory) > sparklineSamples {
+			m.fleetHistory = m.fleetHistory[len(m.fleetHistory)-sparklineSamples:]
 		}
+
 		cmds = append(cmds, tickCmd())
 
 	case logMsg:
 		id := msg.id
 		if id >= 0 && id < len(m.inst) {
 			inst := &m.inst[id]
-			inst.logBuf = append(inst.logBuf, time.Now().Format("15:04:05 ") + msg.line)
+			inst.logBuf = append(inst.logBuf, time.Now().Format("15:04:05 ")+msg.line)
 			if len(inst.logBuf) > 100 {
 				inst.logBuf = inst.logBuf[1:]
 			}
+			if id == m.cursor && m.vpReady {
+				atBottom := m.logVP.AtBottom()
+				m.logVP.SetContent(m.renderLogLines())
+				if atBottom {
+					m.logVP.GotoBottom()
+				}
+			}
 		}
 		return m, listenLogs()
 
+	case diagMsg:
+		return m, tea.Batch(tea.Printf("%s", string(msg)), listenDiag())
+
 	case tea.KeyMsg:
+		if m.filtering {
+			switch msg.String() {
+			case "enter", "esc":
+				m.filtering = false
+				m.filterInput.Blur()
+				if msg.String() == "esc" {
+					m.filterQuery = ""
+					m.filterInput.SetValue("")
+				} else {
+					m.filterQuery = m.filterInput.Value()
+				}
+				m.logVP.SetContent(m.renderLogLines())
+				m.logVP.GotoBottom()
+				return m, nil
+			}
+			var fc tea.Cmd
+			m.filterInput, fc = m.filterInput.Update(msg)
+			m.filterQuery = m.filterInput.Value()
+			m.logVP.SetContent(m.renderLogLines())
+			return m, fc
+		}
+
 		switch msg.String() {
 		case "q":
 			return m, tea.Quit
+		case "tab":
+			if m.focus == "table" {
+				m.focus = "log"
+			} else {
+				m.focus = "table"
+			}
+			return m, nil
 		case "esc":
-			if m.view == "log" {
-				m.view = "dash"
-				m.errMsg = ""
+			if m.focus == "log" {
+				m.focus = "table"
+			}
+			return m, nil
+		case ",":
+			m.splitRatio -= splitStep
+			if m.splitRatio < minSplitRatio {
+				m.splitRatio = minSplitRatio
+			}
+			m.resizeLogVP()
+			return m, nil
+		case ".":
+			m.splitRatio += splitStep
+			if m.splitRatio > maxSplitRatio {
+				m.splitRatio = maxSplitRatio
 			}
+			m.resizeLogVP()
 			return m, nil
 		}
-	}
 
-	var cmd tea.Cmd
-	m.input, cmd = m.input.Update(msg)
-	cmds = append(cmds, cmd)
-	if m.view == "dash" && m.input.Value() != "" {
-		if key, ok := msg.(tea.KeyMsg); ok && key.String() == "enter" {
-			idStr := strings.TrimSpace(m.input.Value())
-			id, err := strconv.Atoi(idStr)
-			if err != nil || id < 0 || id >= len(m.inst) {
-				m.errMsg = "invalid ID"
-				m.input.SetValue("")
-			} else {
-				m.view = "log"
-				m.activeID = id
-				m.input.SetValue("")
-				m.errMsg = ""
+		if m.focus == "table" {
+			switch msg.String() {
+			case "up", "k":
+				if m.cursor > 0 {
+					m.cursor--
+					m.logVP.SetContent(m.renderLogLines())
+					m.logVP.GotoBottom()
+				}
+			case "down", "j":
+				if m.cursor < len(m.inst)-1 {
+					m.cursor++
+					m.logVP.SetContent(m.renderLogLines())
+					m.logVP.GotoBottom()
+				}
+			case "enter":
+				m.focus = "log"
 			}
+			return m, nil
+		}
+
+		// m.focus == "log"
+		switch msg.String() {
+		case "/":
+			m.filtering = true
+			m.filterInput.Focus()
+			return m, nil
+		case "g":
+			m.logVP.GotoTop()
+			return m, nil
+		case "G":
+			m.logVP.GotoBottom()
+			return m, nil
 		}
+		var vc tea.Cmd
+		m.logVP, vc = m.logVP.Update(msg)
+		return m, vc
 	}
 
 	return m, tea.Batch(cmds...)
 }
 
-// View renders the current state as a string for display.
-func (m model) View() string {
-	if m.view == "dash" {
-		return m.dashboardView()
+// resizeLogVP re-fits the log viewport to the current terminal size and
+// split ratio. Called on both tea.WindowSizeMsg and any change to
+// m.splitRatio, since either one changes how wide the right pane is.
+func (m *model) resizeLogVP() {
+	_, rightWidth := m.paneWidths()
+	// The log pane spends three of its rows on its title, the blank line
+	// under it and the filter/hint line; the viewport gets the rest.
+	vpHeight := m.height - headerHeight - footerHeight - 3
+	if vpHeight < 0 {
+		vpHeight = 0
+	}
+	if !m.vpReady {
+		m.logVP = viewport.New(rightWidth, vpHeight)
+		m.vpReady = true
+	} else {
+		m.logVP.Width = rightWidth
+		m.logVP.Height = vpHeight
 	}
-	return m.logView()
+	m.logVP.SetContent(m.renderLogLines())
 }
 
-func (m model) dashboardView() string {
+// paneWidths splits the usable terminal width between the table pane and
+// the log pane according to m.splitRatio, reserving room for the divider
+// and each pane's rounded border.
+func (m model) paneWidths() (left, right int) {
+	usable := m.width - dividerWidth - 4 // 4 = border columns, 2 per pane
+	if usable < 0 {
+		usable = 0
+	}
+	left = int(float64(usable) * m.splitRatio)
+	right = usable - left
+	return left, right
+}
+
+// View renders the persistent split layout: the instance table on the
+// left and the highlighted instance's live log tail on the right.
+func (m model) View() string {
 	var totalTPS int
 	for _, inst := range m.inst {
 		totalTPS += inst.tps
@@ -255,61 +457,175 @@ func (m model) dashboardView() string {
 		avgTPS = totalTPS / len(m.inst)
 	}
 
-	var b strings.Builder
-	b.WriteString(headerStyle.Render(fmt.Sprintf("%d instances live · %d TPS avg", len(m.inst), avgTPS)))
-	b.WriteString("\n\n")
+	header := headerStyle.Render(fmt.Sprintf("%d instances live · %d TPS avg", len(m.inst), avgTPS)) +
+		"  " + sparkline(m.fleetHistory)
+
+	leftWidth, rightWidth := m.paneWidths()
+	paneHeight := m.height - headerHeight - footerHeight
+	if paneHeight < 0 {
+		paneHeight = 0
+	}
+
+	tableStyle, logStyle := unfocusedPaneStyle, unfocusedPaneStyle
+	if m.focus == "table" {
+		tableStyle = focusedPaneStyle
+	} else {
+		logStyle = focusedPaneStyle
+	}
+
+	left := tableStyle.Width(leftWidth).Height(paneHeight).Render(m.tableView(paneHeight))
+	right := logStyle.Width(rightWidth).Height(paneHeight).Render(m.logPaneView())
+
+	// Each bordered pane is paneHeight+2 tall (top/bottom border rows); the
+	// divider needs to match so JoinHorizontal doesn't pad it unevenly.
+	dividerCol := strings.TrimSuffix(strings.Repeat("│\n", paneHeight+2), "\n")
+	divider := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(dividerCol)
 
-	b.WriteString(boldStyle.Render("  ID   TPS   Pending"))
+	panes := lipgloss.JoinHorizontal(lipgloss.Top, left, divider, right)
+
+	footer := "tab focus · ↑/↓ select · enter view log · , . resize · q quit"
+
+	return header + "\n\n" + panes + "\n" + footer
+}
+
+// tableView renders the instance table that fills the left pane, scrolled
+// to keep the cursor row visible within height rows.
+func (m model) tableView(height int) string {
+	var b strings.Builder
+	b.WriteString(boldStyle.Render(" ID   TPS  TPS (30s)  Pending  Queue"))
 	b.WriteString("\n")
 
-	// Calculate visible area accounting for header/footer
-	visibleRows := m.height - 8
-	if m.errMsg != "" {
-		visibleRows--
+	visibleRows := height - 1
+	if visibleRows < 1 {
+		visibleRows = len(m.inst)
 	}
 
-	startIdx := 0
-	endIdx := len(m.inst)
-	if endIdx > visibleRows && visibleRows > 0 {
-		endIdx = startIdx + visibleRows
+	start := 0
+	if m.cursor >= visibleRows {
+		start = m.cursor - visibleRows + 1
+	}
+	end := start + visibleRows
+	if end > len(m.inst) {
+		end = len(m.inst)
 	}
 
-	for i := startIdx; i < endIdx && i < len(m.inst); i++ {
+	for i := start; i < end; i++ {
 		inst := m.inst[i]
-		b.WriteString(fmt.Sprintf("%3d %5d %8d\n", inst.id, inst.tps, inst.pending))
+		pct := 0.0
+		if m.maxPending > 0 {
+			pct = float64(inst.pending) / float64(m.maxPending)
+			if pct > 1 {
+				pct = 1
+			}
+		}
+		row := fmt.Sprintf("%3d %5d  %-10s %7d  %s",
+			inst.id, inst.tps, sparkline(inst.tpsHistory), inst.pending, m.progressBar.ViewAs(pct))
+		if i == m.cursor {
+			row = cursorStyle.Render("▶" + row)
+		} else {
+			row = " " + row
+		}
+		b.WriteString(row)
+		b.WriteString("\n")
 	}
 
-	if endIdx < len(m.inst) {
-		b.WriteString(fmt.Sprintf("  ... %d more instances ...\n", len(m.inst)-endIdx))
-	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
 
-	b.WriteString("\nSelect instance > ")
-	b.WriteString(m.input.View())
+// logPaneView renders the right pane: the live log tail of whichever
+// instance the table cursor is on, plus the filter/scroll hint line.
+func (m model) logPaneView() string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render(fmt.Sprintf("Logs — instance %d", m.cursor)))
+	b.WriteString("\n\n")
 
-	if m.errMsg != "" {
-		b.WriteString("\n")
-		b.WriteString(errorStyle.Render(m.errMsg))
+	if m.vpReady {
+		b.WriteString(m.logVP.View())
+	}
+
+	b.WriteString("\n")
+	if m.filtering {
+		b.WriteString("filter> ")
+		b.WriteString(m.filterInput.View())
+	} else if m.filterQuery != "" {
+		b.WriteString(fmt.Sprintf("filter: %q (/ to edit, esc while editing clears)", m.filterQuery))
+	} else {
+		b.WriteString("/ filter · g/G top/bottom")
 	}
 
 	return b.String()
 }
 
-func (m model) logView() string {
-	var b strings.Builder
-	b.WriteString(headerStyle.Render(fmt.Sprintf("Logs — instance %d   (ESC to back)", m.activeID)))
-	b.WriteString("\n\n")
+// renderLogLines builds the viewport content for the active instance's log
+// buffer. When a filter query is set, lines are fuzzy-matched against it and
+// only the matches are shown, with matched runes highlighted; the underlying
+// logBuf is never mutated so scrolling back to older entries keeps working
+// even as new lines stream in.
+func (m model) renderLogLines() string {
+	if m.cursor < 0 || m.cursor >= len(m.inst) {
+		return ""
+	}
+	lines := m.inst[m.cursor].logBuf
 
-	if m.activeID >= 0 && m.activeID < len(m.inst) {
-		inst := m.inst[m.activeID]
-		// Show only last 20 log lines
-		start := 0
-		if len(inst.logBuf) > 20 {
-			start = len(inst.logBuf) - 20
+	if m.filterQuery == "" {
+		return strings.Join(lines, "\n")
+	}
+
+	matches := fuzzy.Find(m.filterQuery, lines)
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Index < matches[j].Index })
+	rendered := make([]string, 0, len(matches))
+	for _, match := range matches {
+		rendered = append(rendered, highlightMatch(match))
+	}
+	return strings.Join(rendered, "\n")
+}
+
+// sparkChars maps a normalized sample into one of eight block heights,
+// from lowest to highest.
+var sparkChars = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline renders samples as a compact string of unicode block
+// characters scaled between the series' own min and max, so a flat series
+// renders as a flat line rather than being stretched to fill the range.
+func sparkline(samples []int) string {
+	if len(samples) == 0 {
+		return ""
+	}
+	min, max := samples[0], samples[0]
+	for _, v := range samples {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
 		}
-		lines := strings.Join(inst.logBuf[start:], "\n")
-		b.WriteString(lines)
-		b.WriteString("\n")
 	}
 
+	var b strings.Builder
+	for _, v := range samples {
+		if max == min {
+			b.WriteRune(sparkChars[0])
+			continue
+		}
+		idx := (v - min) * (len(sparkChars) - 1) / (max - min)
+		b.WriteRune(sparkChars[idx])
+	}
 	return b.String()
-}
\ No newline at end of file
+}
+
+// highlightMatch renders a fuzzy.Match with its matched rune indexes styled.
+func highlightMatch(match fuzzy.Match) string {
+	var b strings.Builder
+	matched := make(map[int]bool, len(match.MatchedIndexes))
+	for _, idx := range match.MatchedIndexes {
+		matched[idx] = true
+	}
+	for i, r := range []rune(match.Str) {
+		if matched[i] {
+			b.WriteString(matchStyle.Render(string(r)))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
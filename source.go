@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"runtime/debug"
+	"time"
+)
+
+// Metrics is a point-in-time snapshot of an instance's throughput and
+// backlog, as reported by a Source.
+type Metrics struct {
+	TPS     int
+	Pending int
+}
+
+// Source produces a live stream of metrics and log lines for a single
+// instance. Start must return immediately; both returned channels are
+// closed when the underlying feed ends (process exit, watcher stopped,
+// ctx cancelled). Implementations are responsible for their own internal
+// goroutines and must stop them when ctx is done.
+type Source interface {
+	Start(ctx context.Context) (<-chan Metrics, <-chan string, error)
+}
+
+// DummySource fabricates TPS/pending/log activity for an instance. It is
+// the default when no --config is given, and is what main() used to
+// generate inline before sources were pluggable.
+type DummySource struct {
+	ID int
+}
+
+// Start implements Source.
+func (s DummySource) Start(ctx context.Context) (<-chan Metrics, <-chan string, error) {
+	metricsCh := make(chan Metrics)
+	logCh := make(chan string)
+
+	go func() {
+		defer close(metricsCh)
+		defer close(logCh)
+		defer func() {
+			if r := recover(); r != nil {
+				diag <- fmt.Sprintf("instance %d (dummy source) panicked: %v\n%s", s.ID, r, debug.Stack())
+			}
+		}()
+
+		sample := []string{
+			"Getting latest blockhash...",
+			"Got blockhash: %s",
+			"→ Transaction: %s… to %s…",
+			"Batch sent: %d/%d successful",
+		}
+
+		for {
+			delay := time.Duration(400+rand.Intn(400)) * time.Millisecond
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+
+			m := Metrics{TPS: rand.Intn(50) + 10, Pending: rand.Intn(20)}
+
+			var line string
+			switch n := rand.Intn(4); n {
+			case 0:
+				line = fmt.Sprintf("[Instance %d] %s", s.ID, sample[n])
+			case 1:
+				bh := randSeq(6)
+				line = fmt.Sprintf("[Instance %d] %s", s.ID, fmt.Sprintf(sample[n], bh))
+			case 2:
+				sig := randSeq(7)
+				dest := randSeq(5)
+				line = fmt.Sprintf("[Instance %d] %s", s.ID, fmt.Sprintf(sample[n], sig, dest))
+			case 3:
+				good, total := 30, 30
+				line = fmt.Sprintf("[Instance %d] %s", s.ID, fmt.Sprintf(sample[n], good, total))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case metricsCh <- m:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case logCh <- line:
+			}
+		}
+	}()
+
+	return metricsCh, logCh, nil
+}
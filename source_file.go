@@ -0,0 +1,235 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// markerDebounce is how often a burst of dropped lines collapses into a
+// single "N lines dropped" marker, so a rapidly-growing file can't flood
+// the log channel with drop notices on top of the lines it's already
+// dropping.
+const markerDebounce = 2 * time.Second
+
+// FileSource tails a log file on disk, emitting each appended line as it's
+// written. It follows truncation (size shrinks) by reading the same file
+// again from its start, and rotation (the path is recreated under a new
+// inode) by reopening and reading from the start of the new file, the same
+// way `tail -F` does.
+type FileSource struct {
+	ID   int
+	Path string
+}
+
+// Start implements Source. FileSource never derives Metrics from file
+// content, so the returned metrics channel is only ever closed, never
+// sent on.
+func (s FileSource) Start(ctx context.Context) (<-chan Metrics, <-chan string, error) {
+	f, err := os.Open(s.Path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open %s: %w", s.Path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, fmt.Errorf("stat %s: %w", s.Path, err)
+	}
+	if _, err := f.Seek(0, io.SeekEnd); err != nil {
+		f.Close()
+		return nil, nil, fmt.Errorf("seek %s: %w", s.Path, err)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		f.Close()
+		return nil, nil, fmt.Errorf("watch %s: %w", s.Path, err)
+	}
+	if err := watcher.Add(s.Path); err != nil {
+		f.Close()
+		watcher.Close()
+		return nil, nil, fmt.Errorf("watch %s: %w", s.Path, err)
+	}
+
+	metricsCh := make(chan Metrics)
+	// Buffered and drop-oldest: a file growing faster than the dashboard
+	// can keep up shouldn't block the watcher loop or stall other
+	// instances sharing logBus.
+	logCh := make(chan string, 256)
+
+	go func() {
+		defer close(metricsCh)
+		defer close(logCh)
+		defer watcher.Close()
+		defer f.Close()
+		defer func() {
+			if r := recover(); r != nil {
+				diag <- fmt.Sprintf("instance %d (file source) panicked: %v\n%s", s.ID, r, debug.Stack())
+			}
+		}()
+
+		reader := bufio.NewReader(f)
+		curInfo := info
+		var dropped int
+		lastMarker := time.Now()
+		var partial strings.Builder // bytes read since the last newline
+
+		emit := func(line string) {
+			select {
+			case logCh <- line:
+				return
+			default:
+			}
+			// Channel full: drop the oldest buffered line to make room.
+			select {
+			case <-logCh:
+				dropped++
+			default:
+			}
+			select {
+			case logCh <- line:
+			default:
+			}
+			if dropped > 0 && time.Since(lastMarker) >= markerDebounce {
+				select {
+				case <-logCh:
+					dropped++ // this eviction is itself a dropped line
+				default:
+				}
+				marker := fmt.Sprintf("⚠ %d lines dropped (log growing faster than the viewport can drain)", dropped)
+				select {
+				case logCh <- marker:
+				default:
+				}
+				dropped = 0
+				lastMarker = time.Now()
+			}
+		}
+
+		// readAppended drains whatever the file gained since the last read.
+		// A write can land mid-line, so a chunk with no trailing newline is
+		// held in partial and prefixed onto the next chunk rather than
+		// emitted early — the same approach `tail -f` uses to avoid
+		// splitting a line across two events.
+		readAppended := func() {
+			for {
+				chunk, err := reader.ReadString('\n')
+				if strings.HasSuffix(chunk, "\n") {
+					partial.WriteString(strings.TrimSuffix(chunk, "\n"))
+					emit(partial.String())
+					partial.Reset()
+				} else if chunk != "" {
+					partial.WriteString(chunk)
+				}
+				if err != nil {
+					return
+				}
+			}
+		}
+
+		reopen := func() error {
+			newF, err := os.Open(s.Path)
+			if err != nil {
+				return err
+			}
+			newInfo, err := newF.Stat()
+			if err != nil {
+				newF.Close()
+				return err
+			}
+			f.Close()
+			f = newF
+			reader = bufio.NewReader(f)
+			curInfo = newInfo
+			partial.Reset()
+			return nil
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case ev, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if ev.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+					// The old path may reappear shortly (log rotation), but
+					// there's no bound on how shortly — a delayed logrotate
+					// or restart can take far longer than a few seconds.
+					// Retry indefinitely with backoff rather than giving up
+					// and going silently dark; warn once via diag so the
+					// operator knows the feed is stalled while we wait.
+					warned := false
+					backoff := 100 * time.Millisecond
+					const maxBackoff = 5 * time.Second
+					for {
+						if err := reopen(); err == nil {
+							if err := watcher.Add(s.Path); err == nil {
+								break
+							}
+						}
+						if !warned {
+							diag <- fmt.Sprintf("instance %d (file source): %s missing after rotate/remove, retrying...", s.ID, s.Path)
+							warned = true
+						}
+						select {
+						case <-ctx.Done():
+							return
+						case <-time.After(backoff):
+						}
+						if backoff < maxBackoff {
+							backoff *= 2
+							if backoff > maxBackoff {
+								backoff = maxBackoff
+							}
+						}
+					}
+					continue
+				}
+				if ev.Op&fsnotify.Write == 0 {
+					continue
+				}
+
+				newInfo, err := os.Stat(s.Path)
+				if err != nil {
+					continue
+				}
+				switch {
+				case !os.SameFile(curInfo, newInfo):
+					// Rotated: a new file now lives at this path (reopen
+					// also drops any partial line buffered from the old
+					// one, which can never be completed).
+					if err := reopen(); err != nil {
+						continue
+					}
+				case newInfo.Size() < curInfo.Size():
+					// Truncated in place; same reasoning as rotation.
+					if _, err := f.Seek(0, io.SeekStart); err != nil {
+						continue
+					}
+					reader.Reset(f)
+					partial.Reset()
+				}
+				curInfo = newInfo
+				readAppended()
+
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				emit(fmt.Sprintf("[watch error] %v", err))
+			}
+		}
+	}()
+
+	return metricsCh, logCh, nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"regexp"
+	"runtime/debug"
+	"strconv"
+)
+
+// ParseRule extracts a metric field from a log line. Pattern must contain
+// exactly one capture group; the captured text is parsed as an int and
+// assigned to Field ("tps" or "pending").
+type ParseRule struct {
+	Pattern *regexp.Regexp
+	Field   string
+}
+
+// ProcessSource spawns Command as a child process per instance and scrapes
+// its combined stdout/stderr line-by-line, applying Rules to derive
+// Metrics updates. Raw lines are always forwarded to the log channel
+// regardless of whether they matched a rule.
+type ProcessSource struct {
+	ID      int
+	Command string
+	Args    []string
+	Rules   []ParseRule
+}
+
+// Start implements Source.
+func (s ProcessSource) Start(ctx context.Context) (<-chan Metrics, <-chan string, error) {
+	cmd := exec.CommandContext(ctx, s.Command, s.Args...)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, nil, err
+	}
+	cmd.Stderr = cmd.Stdout // scrape both streams through the same pipe
+
+	if err := cmd.Start(); err != nil {
+		return nil, nil, err
+	}
+
+	metricsCh := make(chan Metrics)
+	logCh := make(chan string)
+
+	go func() {
+		defer close(metricsCh)
+		defer close(logCh)
+		defer cmd.Wait()
+		defer func() {
+			if r := recover(); r != nil {
+				diag <- fmt.Sprintf("instance %d (process source) panicked: %v\n%s", s.ID, r, debug.Stack())
+			}
+		}()
+
+		// last accumulates the most recent value seen for each field so a
+		// line that only matches one rule (e.g. a tps-only line) doesn't
+		// blank out the other field — only fields a rule actually touches
+		// are updated, everything else carries forward.
+		var last Metrics
+
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			line := scanner.Text()
+
+			select {
+			case <-ctx.Done():
+				return
+			case logCh <- line:
+			}
+
+			if applyRules(s.Rules, line, &last) {
+				select {
+				case <-ctx.Done():
+					return
+				case metricsCh <- last:
+				}
+			}
+		}
+		if err := scanner.Err(); err != nil && err != io.EOF {
+			select {
+			case <-ctx.Done():
+			case logCh <- "[source error] " + err.Error():
+			}
+		}
+	}()
+
+	return metricsCh, logCh, nil
+}
+
+// applyRules runs each rule against line, merging any matches into m —
+// only fields a rule actually matched are overwritten, so callers can pass
+// the same m across lines to accumulate last-known-good values instead of
+// losing whichever field didn't match this line. Returns whether anything
+// matched.
+func applyRules(rules []ParseRule, line string, m *Metrics) bool {
+	matched := false
+	for _, r := range rules {
+		groups := r.Pattern.FindStringSubmatch(line)
+		if len(groups) < 2 {
+			continue
+		}
+		v, err := strconv.Atoi(groups[1])
+		if err != nil {
+			continue
+		}
+		switch r.Field {
+		case "tps":
+			m.TPS = v
+			matched = true
+		case "pending":
+			m.Pending = v
+			matched = true
+		}
+	}
+	return matched
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime/debug"
+
+	"github.com/creack/pty"
+)
+
+// PTYSource runs Command attached to a pseudo-terminal rather than plain
+// pipes, so interactive programs (python, node, anything that checks
+// isatty or redraws with ANSI control codes) render the way they would in
+// a real terminal instead of falling back to non-interactive output.
+type PTYSource struct {
+	ID      int
+	Command string
+	Args    []string
+	Rules   []ParseRule
+}
+
+// Start implements Source.
+func (s PTYSource) Start(ctx context.Context) (<-chan Metrics, <-chan string, error) {
+	cmd := exec.CommandContext(ctx, s.Command, s.Args...)
+
+	f, err := pty.Start(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		f.Close()
+	}()
+
+	metricsCh := make(chan Metrics)
+	logCh := make(chan string)
+
+	go func() {
+		defer close(metricsCh)
+		defer close(logCh)
+		defer f.Close()
+		defer cmd.Wait()
+		defer func() {
+			if r := recover(); r != nil {
+				diag <- fmt.Sprintf("instance %d (pty source) panicked: %v\n%s", s.ID, r, debug.Stack())
+			}
+		}()
+
+		// last accumulates the most recent value seen for each field so a
+		// line that only matches one rule doesn't blank out the other —
+		// see applyRules in source_process.go.
+		var last Metrics
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := scanner.Text()
+
+			select {
+			case <-ctx.Done():
+				return
+			case logCh <- line:
+			}
+
+			if applyRules(s.Rules, line, &last) {
+				select {
+				case <-ctx.Done():
+					return
+				case metricsCh <- last:
+				}
+			}
+		}
+		// A PTY read returns an error (not clean EOF) once the child exits
+		// and the master side is closed; that's expected, not a failure.
+	}()
+
+	return metricsCh, logCh, nil
+}